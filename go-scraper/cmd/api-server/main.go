@@ -0,0 +1,29 @@
+package main
+
+import (
+	"flag"
+	"net/http"
+
+	"github.com/ajiteshreddy7/yc-go-scraper/internal/api"
+	"github.com/ajiteshreddy7/yc-go-scraper/internal/db"
+	"github.com/ajiteshreddy7/yc-go-scraper/internal/logger"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "address to listen on")
+	flag.Parse()
+
+	logger.InitFromEnv()
+
+	d, err := db.Connect()
+	if err != nil {
+		logger.Fatal("db connect: %v", err)
+	}
+	defer d.Close()
+
+	s := api.NewServer(d)
+	logger.Info("Serving jobs API on %s", *addr)
+	if err := http.ListenAndServe(*addr, s.Handler()); err != nil {
+		logger.Fatal("listen: %v", err)
+	}
+}
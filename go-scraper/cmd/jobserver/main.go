@@ -0,0 +1,139 @@
+// Command jobserver runs the job tracker's scheduled tasks (scraping,
+// dead-link checks, static site regeneration) as a long-running service
+// instead of one-shot CLI invocations.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"net/http"
+	"os"
+	"os/exec"
+	"time"
+
+	"github.com/ajiteshreddy7/yc-go-scraper/internal/db"
+	"github.com/ajiteshreddy7/yc-go-scraper/internal/jobs"
+	"github.com/ajiteshreddy7/yc-go-scraper/internal/logger"
+)
+
+const (
+	taskYCombinatorScraper    = "ycombinator_scraper"
+	taskDeadLinkChecker       = "dead_link_checker"
+	taskStaticSiteRegenerator = "static_site_regenerator"
+)
+
+func main() {
+	pollInterval := flag.Duration("poll-interval", 30*time.Second, "how often to poll for due tasks")
+	leaseFor := flag.Duration("lease", 5*time.Minute, "how long a claimed task's lease lasts before it's reclaimable")
+	owner := flag.String("owner", "", "lease owner identity (defaults to hostname)")
+	flag.Parse()
+
+	if *owner == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			hostname = "jobserver"
+		}
+		*owner = hostname
+	}
+
+	logger.InitFromEnv()
+
+	d, err := db.Connect()
+	if err != nil {
+		logger.Fatal("db connect: %v", err)
+	}
+	defer d.Close()
+
+	store := jobs.NewStore(d.Conn)
+	if err := store.CreateSchema(); err != nil {
+		logger.Fatal("create scheduled_tasks schema: %v", err)
+	}
+
+	registry := jobs.NewRegistry()
+	registry.Register(taskYCombinatorScraper, scraperWorker{}, jobs.IntervalScheduler{Every: 6 * time.Hour})
+	registry.Register(taskDeadLinkChecker, deadLinkWorker{db: d}, jobs.IntervalScheduler{Every: 24 * time.Hour})
+	registry.Register(taskStaticSiteRegenerator, staticSiteWorker{}, jobs.IntervalScheduler{Every: time.Hour})
+
+	runner := jobs.NewRunner(store, registry, *owner, *leaseFor)
+
+	logger.Info("jobserver starting as %q, polling every %s", *owner, *pollInterval)
+	ctx := context.Background()
+	for {
+		claimed, err := runner.PollOnce(ctx, 10)
+		if err != nil {
+			logger.Error("poll: %v", err)
+		} else if claimed > 0 {
+			logger.Info("ran %d task(s)", claimed)
+		}
+		time.Sleep(*pollInterval)
+	}
+}
+
+// scraperWorker runs the YC job scraper. The scraper itself lives outside
+// this snapshot of the repo, so this registers the task type and reports a
+// clear error until it's wired up, rather than silently doing nothing.
+type scraperWorker struct{}
+
+func (scraperWorker) Run(ctx context.Context, payload json.RawMessage) error {
+	return errors.New("ycombinator_scraper: no scraper binary configured")
+}
+
+// deadLinkWorker HEAD-requests every job URL and logs the ones that no
+// longer resolve, so stale postings can be flagged without a human having
+// to click through each one.
+type deadLinkWorker struct {
+	db *db.DB
+}
+
+func (w deadLinkWorker) Run(ctx context.Context, payload json.RawMessage) error {
+	rows, err := w.db.Conn.QueryContext(ctx, `SELECT id, url FROM job_applications`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	var checked, dead int
+	for rows.Next() {
+		var id int
+		var url string
+		if err := rows.Scan(&id, &url); err != nil {
+			return err
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
+		if err != nil {
+			continue
+		}
+		resp, err := client.Do(req)
+		checked++
+		if err != nil || resp.StatusCode >= 400 {
+			dead++
+			logger.Error("dead link: job %d: %s", id, url)
+		}
+		if resp != nil {
+			resp.Body.Close()
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	logger.Info("dead_link_checker: checked %d jobs, %d dead", checked, dead)
+	return nil
+}
+
+// staticSiteWorker regenerates the public static site by invoking the
+// static-site binary on its own registered interval, so the site is
+// refreshed without a human running `static-site` by hand. It is not
+// triggered by a scrape; it runs only when its own task comes due.
+type staticSiteWorker struct{}
+
+func (staticSiteWorker) Run(ctx context.Context, payload json.RawMessage) error {
+	cmd := exec.CommandContext(ctx, "static-site")
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
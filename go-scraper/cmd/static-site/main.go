@@ -1,80 +1,27 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"flag"
+	"fmt"
 	"html/template"
 	"os"
 	"path/filepath"
-	"regexp"
 	"sort"
 	"strings"
-	"time"
 
 	"github.com/ajiteshreddy7/yc-go-scraper/internal/db"
 	"github.com/ajiteshreddy7/yc-go-scraper/internal/logger"
 )
 
-type Job struct {
-	ID        int
-	Title     string
-	Company   string
-	Location  string
-	Type      string
-	URL       string
-	DateAdded time.Time
-	Status    string
-}
+// Job is an alias for db.Job, kept so existing template fields (Job.Title,
+// Job.DateAdded, ...) read the same as before QueryJobs moved into internal/db.
+type Job = db.Job
 
-// deriveLevels returns canonical level labels found in a job title
-func deriveLevels(title string) []string {
-	t := strings.ToLower(title)
-	var out []string
-	add := func(s string) { out = append(out, s) }
-	if matched, _ := regexp.MatchString(`\bintern(ship)?\b`, t); matched {
-		add("Intern")
-	}
-	if strings.Contains(t, "new grad") || strings.Contains(t, "new graduate") {
-		add("New Grad")
-	}
-	if strings.Contains(t, "entry level") || strings.Contains(t, "entry-level") {
-		add("Entry Level")
-	}
-	if strings.Contains(t, "junior") {
-		add("Junior")
-	}
-	if strings.Contains(t, "associate") {
-		add("Associate")
-	}
-	if strings.Contains(t, "apprentice") {
-		add("Apprentice")
-	}
-	if strings.Contains(t, "fellow") {
-		add("Fellow")
-	}
-	if strings.Contains(t, "co-op") || strings.Contains(t, "co op") || strings.Contains(t, "coop") {
-		add("Co-op")
-	}
-	if len(out) == 0 {
-		if matched, _ := regexp.MatchString(`\b(engineer|developer|analyst|specialist|coordinator)\b`, t); matched {
-			if ok, _ := regexp.MatchString(`\b(senior|staff|principal|lead|manager|director|architect|head|chief|vp)\b`, t); !ok {
-				add("Entry Level")
-			}
-		}
-	}
-	if len(out) > 1 {
-		seen := map[string]bool{}
-		uniq := []string{}
-		for _, v := range out {
-			if !seen[v] {
-				seen[v] = true
-				uniq = append(uniq, v)
-			}
-		}
-		out = uniq
-	}
-	return out
-}
+// deriveLevels is an alias for db.DeriveLevels, the single source of truth
+// for level labels (see internal/db for why).
+var deriveLevels = db.DeriveLevels
 
 const indexHTML = `<!DOCTYPE html>
 <html>
@@ -110,6 +57,21 @@ const indexHTML = `<!DOCTYPE html>
         .status-applied { color: #28a745; font-weight: bold; }
         .export-btn { background: #28a745; margin-left: 10px; }
         .export-btn:hover { background: #218838; }
+        .pagination { display: flex; justify-content: center; align-items: center; gap: 15px; padding: 15px; }
+        .pagination button:disabled { background: #adb5bd; cursor: not-allowed; }
+        .tabs { display: flex; gap: 10px; margin-bottom: 20px; }
+        .tab-btn { background: white; color: #495057; border: 1px solid #ced4da; }
+        .tab-btn.active { background: #007bff; color: white; }
+        .trend-card { padding: 20px; margin-bottom: 20px; }
+        .trend-card h2 { font-size: 1.1em; color: #343a40; margin-bottom: 15px; }
+        .bar-row { display: flex; align-items: center; gap: 10px; margin-bottom: 8px; }
+        .bar-label { min-width: 160px; color: #495057; font-size: 0.9em; }
+        .bar-track { flex: 1; background: #e9ecef; border-radius: 4px; overflow: hidden; }
+        .bar-fill { background: #007bff; color: white; padding: 4px 8px; font-size: 0.85em; white-space: nowrap; }
+        th.sortable { cursor: pointer; user-select: none; }
+        th.sortable:hover { background: #0069d9; }
+        .sort-arrow { font-size: 0.8em; }
+        .sort-notice { font-size: 0.85em; color: #856404; background: #fff3cd; padding: 10px 14px; border-radius: 6px; margin-bottom: 15px; }
     </style>
 </head>
 <body>
@@ -130,11 +92,17 @@ const indexHTML = `<!DOCTYPE html>
                 <div class="stat-label">Applied</div>
             </div>
         </div>
-        
+
+        <div class="tabs">
+            <button class="tab-btn active" id="tab-table" onclick="showTab('table')">Table</button>
+            <button class="tab-btn" id="tab-trends" onclick="showTab('trends')">Trends</button>
+        </div>
+
+        <div id="view-table">
         <div class="filters">
             <div class="filter-row">
                 <label>Search:</label>
-                <input type="text" id="search" placeholder="Search by title, company, location..." />
+                <input type="text" id="search" placeholder='Search, or use tags: title:"ml engineer" company:openai level:intern is:not-applied' />
             </div>
             <div class="filter-row">
                 <label>Job Levels:</label>
@@ -170,22 +138,28 @@ const indexHTML = `<!DOCTYPE html>
             </div>
         </div>
         
+        <div id="sort-scope-notice" class="sort-notice" style="display: none;">
+            Sort applies only to the jobs loaded on the current page &mdash; switch pages to see it re-applied there.
+        </div>
+        <div id="filter-scope-notice" class="sort-notice" style="display: none;">
+            No live API configured, so filters only search the jobs loaded on the current page &mdash; other pages may have matches too.
+        </div>
         <div class="jobs-table">
             <table>
                 <thead>
                     <tr>
-                        <th>Date</th>
-                        <th>Company</th>
-                        <th>Title</th>
-                        <th>Location</th>
-                        <th>Level</th>
-                        <th>Status</th>
+                        <th class="sortable" data-column="date" onclick="onHeaderClick('date', event)">Date <span class="sort-arrow" id="sort-arrow-date"></span></th>
+                        <th class="sortable" data-column="company" onclick="onHeaderClick('company', event)">Company <span class="sort-arrow" id="sort-arrow-company"></span></th>
+                        <th class="sortable" data-column="title" onclick="onHeaderClick('title', event)">Title <span class="sort-arrow" id="sort-arrow-title"></span></th>
+                        <th class="sortable" data-column="location" onclick="onHeaderClick('location', event)">Location <span class="sort-arrow" id="sort-arrow-location"></span></th>
+                        <th class="sortable" data-column="level" onclick="onHeaderClick('level', event)">Level <span class="sort-arrow" id="sort-arrow-level"></span></th>
+                        <th class="sortable" data-column="status" onclick="onHeaderClick('status', event)">Status <span class="sort-arrow" id="sort-arrow-status"></span></th>
                         <th>Link</th>
                     </tr>
                 </thead>
                 <tbody id="jobs-body">
                     {{range .Jobs}}
-                    <tr data-title="{{.Title}}" data-company="{{.Company}}" data-location="{{.Location}}" data-levels="{{.Levels}}" data-status="{{.Status}}">
+                    <tr data-title="{{.Title}}" data-company="{{.Company}}" data-location="{{.Location}}" data-levels="{{.Levels}}" data-status="{{.Status}}" data-date="{{.DateAdded.Format "2006-01-02T15:04:05Z07:00"}}">
                         <td>{{.DateAdded}}</td>
                         <td>{{.Company}}</td>
                         <td>{{.Title}}</td>
@@ -197,37 +171,495 @@ const indexHTML = `<!DOCTYPE html>
                     {{end}}
                 </tbody>
             </table>
+            <div class="pagination">
+                <button id="prev-page" onclick="goToPage(currentPage - 1)" {{if not .HasPrevPage}}disabled{{end}}>&laquo; Prev</button>
+                <span id="page-indicator">Page {{.CurrentPage}}</span>
+                <button id="next-page" onclick="goToPage(currentPage + 1)" {{if not .HasNextPage}}disabled{{end}}>Next &raquo;</button>
+            </div>
+        </div>
+        </div>
+
+        <div id="view-trends" style="display: none;">
+            <div class="jobs-table trend-card">
+                <h2>By Company</h2>
+                <div id="trend-company" class="bar-chart"></div>
+            </div>
+            <div class="jobs-table trend-card">
+                <h2>By Level</h2>
+                <div id="trend-level" class="bar-chart"></div>
+            </div>
+            <div class="jobs-table trend-card">
+                <h2>By Location</h2>
+                <div id="trend-location" class="bar-chart"></div>
+            </div>
         </div>
     </div>
-    
+
     <script>
-        const allJobs = Array.from(document.querySelectorAll('#jobs-body tr'));
+        let currentPage = {{.CurrentPage}};
+        let allJobs = Array.from(document.querySelectorAll('#jobs-body tr'));
+        let trendsLoaded = false;
+
+        // API_BASE points at a live api-server (see the -api-base flag on
+        // the static-site generator). When set, search/filter/sort query
+        // /api/jobs directly so results aren't limited to the currently
+        // loaded jobs-N.json page. When empty (the default, fully-static
+        // deployment), filtering and sorting stay scoped to that page.
+        const API_BASE = {{.APIBase}};
+
+        function apiEnabled() {
+            return API_BASE !== '';
+        }
+
+        // sortState is an ordered list of {column, direction}; the first
+        // entry is the primary sort key, later entries (added via
+        // shift-click) break ties in the ones before them.
+        const SORT_COLUMNS = ['date', 'company', 'title', 'location', 'level', 'status'];
+        let sortState = loadSortState();
+
+        function loadSortState() {
+            const params = new URLSearchParams(window.location.search);
+            const fromURL = params.get('sort');
+            const raw = fromURL !== null ? fromURL : localStorage.getItem('jobSort');
+            if (!raw) return [];
+            return raw.split(',').map(part => {
+                const [column, direction] = part.split(':');
+                return { column: column, direction: direction === 'desc' ? 'desc' : 'asc' };
+            }).filter(s => SORT_COLUMNS.includes(s.column));
+        }
+
+        function saveSortState() {
+            const encoded = sortState.map(s => s.column + ':' + s.direction).join(',');
+            if (encoded) {
+                localStorage.setItem('jobSort', encoded);
+            } else {
+                localStorage.removeItem('jobSort');
+            }
+            const url = new URL(window.location);
+            if (encoded) {
+                url.searchParams.set('sort', encoded);
+            } else {
+                url.searchParams.delete('sort');
+            }
+            window.history.replaceState({}, '', url);
+        }
+
+        function onHeaderClick(column, event) {
+            const existing = sortState.find(s => s.column === column);
+            if (event && event.shiftKey) {
+                if (existing) {
+                    existing.direction = existing.direction === 'asc' ? 'desc' : 'asc';
+                } else {
+                    sortState.push({ column: column, direction: 'asc' });
+                }
+            } else if (existing && sortState.length === 1) {
+                existing.direction = existing.direction === 'asc' ? 'desc' : 'asc';
+            } else {
+                sortState = [{ column: column, direction: 'asc' }];
+            }
+            saveSortState();
+            if (apiEnabled()) {
+                // Re-query so the sort is applied to the whole filtered
+                // result set server-side, not just the page in the DOM.
+                queryAPI(currentPage).catch(() => {});
+                return;
+            }
+            applySort();
+        }
+
+        function rowSortValue(row, column) {
+            switch (column) {
+                case 'date': return Date.parse(row.dataset.date) || 0;
+                case 'company': return row.dataset.company.toLowerCase();
+                case 'title': return row.dataset.title.toLowerCase();
+                case 'location': return row.dataset.location.toLowerCase();
+                case 'level': return row.dataset.levels.toLowerCase();
+                case 'status': return row.dataset.status.toLowerCase();
+                default: return '';
+            }
+        }
+
+        // hasMultiplePages reports whether the generated site spans more than
+        // one jobs-N.json page, i.e. whether applySort's in-page sort would
+        // only be reordering a slice of the full archive.
+        function hasMultiplePages() {
+            const next = document.getElementById('next-page');
+            const prev = document.getElementById('prev-page');
+            return (next && !next.disabled) || (prev && !prev.disabled);
+        }
+
+        function applySort() {
+            SORT_COLUMNS.forEach(col => {
+                const arrow = document.getElementById('sort-arrow-' + col);
+                if (arrow) arrow.textContent = '';
+            });
+            sortState.forEach(s => {
+                const arrow = document.getElementById('sort-arrow-' + s.column);
+                if (arrow) arrow.textContent = s.direction === 'asc' ? '▲' : '▼';
+            });
+
+            const notice = document.getElementById('sort-scope-notice');
+            if (notice) {
+                notice.style.display = (!apiEnabled() && sortState.length > 0 && hasMultiplePages()) ? '' : 'none';
+            }
+
+            if (sortState.length === 0) return;
+            const tbody = document.getElementById('jobs-body');
+            const rows = Array.from(tbody.querySelectorAll('tr'));
+            rows.sort((a, b) => {
+                for (const s of sortState) {
+                    const va = rowSortValue(a, s.column);
+                    const vb = rowSortValue(b, s.column);
+                    if (va < vb) return s.direction === 'asc' ? -1 : 1;
+                    if (va > vb) return s.direction === 'asc' ? 1 : -1;
+                }
+                return 0;
+            });
+            rows.forEach(row => tbody.appendChild(row));
+            allJobs = rows;
+        }
+
+        function showTab(tab) {
+            document.getElementById('view-table').style.display = tab === 'table' ? '' : 'none';
+            document.getElementById('view-trends').style.display = tab === 'trends' ? '' : 'none';
+            document.getElementById('tab-table').classList.toggle('active', tab === 'table');
+            document.getElementById('tab-trends').classList.toggle('active', tab === 'trends');
+            if (tab === 'trends' && !trendsLoaded) {
+                loadTrends();
+            }
+        }
+
+        function renderBarChart(containerId, groups) {
+            const container = document.getElementById(containerId);
+            container.innerHTML = '';
+            const maxTotal = groups.reduce((max, g) => Math.max(max, g.TotalJobs), 0) || 1;
+            groups.forEach(g => {
+                const pct = Math.round((g.TotalJobs / maxTotal) * 100);
+
+                const label = document.createElement('div');
+                label.className = 'bar-label';
+                label.textContent = g.Group;
+
+                const fill = document.createElement('div');
+                fill.className = 'bar-fill';
+                fill.style.width = pct + '%';
+                fill.textContent = g.TotalJobs;
+
+                const track = document.createElement('div');
+                track.className = 'bar-track';
+                track.appendChild(fill);
+
+                const row = document.createElement('div');
+                row.className = 'bar-row';
+                row.appendChild(label);
+                row.appendChild(track);
+                container.appendChild(row);
+            });
+        }
+
+        function loadTrends() {
+            fetch('stats.json')
+                .then(res => res.json())
+                .then(data => {
+                    renderBarChart('trend-company', (data.ByCompany.Groups || []).slice(0, 15));
+                    renderBarChart('trend-level', data.ByLevel.Groups || []);
+                    renderBarChart('trend-location', (data.ByLocation.Groups || []).slice(0, 15));
+                    trendsLoaded = true;
+                })
+                .catch(() => {});
+        }
+
+        function textCell(text) {
+            const td = document.createElement('td');
+            td.textContent = text;
+            return td;
+        }
+
+        function buildRow(job) {
+            const tr = document.createElement('tr');
+            tr.dataset.title = job.Title;
+            tr.dataset.company = job.Company;
+            tr.dataset.location = job.Location;
+            tr.dataset.levels = job.Levels;
+            tr.dataset.status = job.Status;
+            tr.dataset.date = job.DateAdded;
+
+            tr.appendChild(textCell(job.DateAdded));
+            tr.appendChild(textCell(job.Company));
+            tr.appendChild(textCell(job.Title));
+            tr.appendChild(textCell(job.Location));
+            tr.appendChild(textCell(job.Levels));
+
+            const statusSpan = document.createElement('span');
+            statusSpan.className = 'status-' + job.StatusClass;
+            statusSpan.textContent = job.Status;
+            const statusTd = document.createElement('td');
+            statusTd.appendChild(statusSpan);
+            tr.appendChild(statusTd);
+
+            const link = document.createElement('a');
+            link.href = job.URL;
+            link.target = '_blank';
+            link.textContent = 'Apply';
+            const linkTd = document.createElement('td');
+            linkTd.appendChild(link);
+            tr.appendChild(linkTd);
+
+            return tr;
+        }
+
+        // renderJobs replaces the table with jobs that have NOT already been
+        // filtered/sorted server-side (the pre-generated jobs-N.json path),
+        // so it still applies the local sort and filter afterward.
+        function renderJobs(jobs) {
+            const tbody = document.getElementById('jobs-body');
+            tbody.innerHTML = '';
+            jobs.forEach(job => tbody.appendChild(buildRow(job)));
+            allJobs = Array.from(tbody.querySelectorAll('tr'));
+            applySort();
+            filterJobs();
+        }
+
+        // renderAPIJobs replaces the table with a page of jobs that /api/jobs
+        // already filtered and sorted, so it only refreshes the sort arrows
+        // and stat counts rather than re-filtering or re-sorting them.
+        function renderAPIJobs(jobs) {
+            const tbody = document.getElementById('jobs-body');
+            tbody.innerHTML = '';
+            jobs.forEach(job => tbody.appendChild(buildRow(job)));
+            allJobs = Array.from(tbody.querySelectorAll('tr'));
+
+            // Both notices only ever apply to the static jobs-N.json path;
+            // a live API keeps filter/sort consistent across every page.
+            const sortNotice = document.getElementById('sort-scope-notice');
+            if (sortNotice) sortNotice.style.display = 'none';
+            const filterNotice = document.getElementById('filter-scope-notice');
+            if (filterNotice) filterNotice.style.display = 'none';
+
+            SORT_COLUMNS.forEach(col => {
+                const arrow = document.getElementById('sort-arrow-' + col);
+                if (arrow) arrow.textContent = '';
+            });
+            sortState.forEach(s => {
+                const arrow = document.getElementById('sort-arrow-' + s.column);
+                if (arrow) arrow.textContent = s.direction === 'asc' ? '▲' : '▼';
+            });
+
+            let notApplied = 0;
+            let applied = 0;
+            allJobs.forEach(row => {
+                if (row.dataset.status === 'Applied') applied++;
+                else notApplied++;
+            });
+            document.getElementById('total-jobs').textContent = allJobs.length;
+            document.getElementById('not-applied').textContent = notApplied;
+            document.getElementById('applied').textContent = applied;
+        }
+
+        // buildAPIFilter turns the search box (parsed the same way
+        // ParseSearchQuery parses it server-side) plus the level/company/
+        // location/status controls into a db.JobFilter-shaped object. A
+        // select/checkbox value takes precedence over the same field's
+        // search tag, matching how a more specific control ought to win.
+        function buildAPIFilter(parsed) {
+            const filter = {};
+
+            if (parsed.tags.title.length) filter.Title = { Contains: parsed.tags.title[0] };
+
+            const company = document.getElementById('company').value;
+            if (company) {
+                filter.Company = { Eq: company };
+            } else if (parsed.tags.company.length) {
+                filter.Company = { Contains: parsed.tags.company[0] };
+            }
+
+            const location = document.getElementById('location').value;
+            if (location) {
+                filter.Location = { Eq: location };
+            } else if (parsed.tags.location.length) {
+                filter.Location = { Contains: parsed.tags.location[0] };
+            }
+
+            const levelBoxes = Array.from(document.querySelectorAll('#levels input'));
+            const checkedLevels = levelBoxes.filter(cb => cb.checked).map(cb => cb.value);
+            if (checkedLevels.length > 0 && checkedLevels.length < levelBoxes.length) {
+                filter.Level = { In: checkedLevels };
+            } else if (parsed.tags.level.length) {
+                filter.Level = { Contains: parsed.tags.level[0] };
+            }
+
+            const status = document.getElementById('status').value;
+            if (status) {
+                filter.Status = { Eq: status };
+            } else if (parsed.tags.is.length) {
+                filter.Status = { Eq: parsed.tags.is[0] === 'applied' ? 'Applied' : 'Not Applied' };
+            }
+
+            if (parsed.freeText) filter.FreeText = parsed.freeText;
+
+            return filter;
+        }
+
+        // queryAPI fetches page from /api/jobs using the current search/
+        // filter controls and sortState, so paging and sorting stay
+        // consistent with whatever is currently filtered - the thing the
+        // jobs-N.json + client-side-filter path can't guarantee past page 0.
+        function queryAPI(page) {
+            const parsed = parseSearchQuery(document.getElementById('search').value);
+            const body = {
+                filter: buildAPIFilter(parsed),
+                page: page,
+                order: sortState.map(s => ({ Column: s.column, Direction: s.direction })),
+            };
+            return fetch(API_BASE + '/api/jobs', {
+                method: 'POST',
+                headers: { 'Content-Type': 'application/json' },
+                body: JSON.stringify(body),
+            })
+                .then(res => res.json())
+                .then(data => {
+                    currentPage = page;
+                    document.getElementById('page-indicator').textContent = 'Page ' + page;
+                    document.getElementById('prev-page').disabled = page === 0;
+                    document.getElementById('next-page').disabled = !data.hasNextPage;
+                    renderAPIJobs(data.jobs);
+                    const url = new URL(window.location);
+                    url.searchParams.set('page', page);
+                    window.history.replaceState({}, '', url);
+                });
+        }
+
+        function goToPage(page) {
+            if (page < 0) return;
+            if (apiEnabled()) {
+                queryAPI(page).catch(() => {});
+                return;
+            }
+            fetch('jobs-' + page + '.json')
+                .then(res => res.json())
+                .then(data => {
+                    currentPage = page;
+                    document.getElementById('page-indicator').textContent = 'Page ' + page;
+                    document.getElementById('prev-page').disabled = page === 0;
+                    document.getElementById('next-page').disabled = !data.HasNextPage;
+                    renderJobs(data.Jobs);
+                    const url = new URL(window.location);
+                    url.searchParams.set('page', page);
+                    window.history.replaceState({}, '', url);
+                })
+                .catch(() => {});
+        }
         
+        // KNOWN_SEARCH_TAGS mirrors db.searchTagPrefixes in internal/db/search.go;
+        // an unrecognized prefix degrades to a plain substring match.
+        const KNOWN_SEARCH_TAGS = ['title', 'company', 'location', 'loc', 'level', 'is'];
+
+        // KNOWN_IS_VALUES mirrors the is: values ParseSearchQuery recognizes
+        // in internal/db/search.go; an unrecognized value degrades to a
+        // plain substring match on the whole token, same as an unrecognized
+        // prefix, instead of silently matching "Applied".
+        const KNOWN_IS_VALUES = ['applied', 'not-applied', 'notapplied'];
+
+        function tokenizeSearchQuery(q) {
+            const tokens = [];
+            let cur = '';
+            let inQuotes = false;
+            for (const ch of q) {
+                if (ch === '"') {
+                    inQuotes = !inQuotes;
+                } else if (ch === ' ' && !inQuotes) {
+                    if (cur.length > 0) { tokens.push(cur); cur = ''; }
+                } else {
+                    cur += ch;
+                }
+            }
+            if (cur.length > 0) tokens.push(cur);
+            return tokens;
+        }
+
+        function parseSearchQuery(q) {
+            const tags = { title: [], company: [], location: [], level: [], is: [] };
+            const freeText = [];
+            tokenizeSearchQuery(q).forEach(tok => {
+                const idx = tok.indexOf(':');
+                if (idx > 0) {
+                    const prefix = tok.slice(0, idx).toLowerCase();
+                    const value = tok.slice(idx + 1).toLowerCase();
+                    if (KNOWN_SEARCH_TAGS.includes(prefix) && (prefix !== 'is' || KNOWN_IS_VALUES.includes(value))) {
+                        const key = prefix === 'loc' ? 'location' : prefix;
+                        tags[key].push(value);
+                        return;
+                    }
+                }
+                freeText.push(tok);
+            });
+            return { tags: tags, freeText: freeText.join(' ').toLowerCase() };
+        }
+
+        // hasActiveFilter reports whether any search/filter control is set,
+        // used to decide whether the filter-scope-notice is worth showing.
+        function hasActiveFilter(parsed) {
+            const selectedLevels = Array.from(document.querySelectorAll('#levels input:checked'));
+            const allLevels = document.querySelectorAll('#levels input');
+            return parsed.freeText !== '' ||
+                parsed.tags.title.length > 0 || parsed.tags.company.length > 0 ||
+                parsed.tags.location.length > 0 || parsed.tags.level.length > 0 ||
+                parsed.tags.is.length > 0 ||
+                (selectedLevels.length > 0 && selectedLevels.length < allLevels.length) ||
+                document.getElementById('company').value !== '' ||
+                document.getElementById('location').value !== '' ||
+                document.getElementById('status').value !== '';
+        }
+
         function filterJobs() {
-            const search = document.getElementById('search').value.toLowerCase();
+            const parsed = parseSearchQuery(document.getElementById('search').value);
+
+            if (apiEnabled()) {
+                queryAPI(0).catch(() => {});
+                return;
+            }
+
+            const notice = document.getElementById('filter-scope-notice');
+            if (notice) {
+                notice.style.display = (hasActiveFilter(parsed) && hasMultiplePages()) ? '' : 'none';
+            }
+
             const selectedLevels = Array.from(document.querySelectorAll('#levels input:checked')).map(cb => cb.value.toLowerCase());
             const company = document.getElementById('company').value;
             const location = document.getElementById('location').value;
             const status = document.getElementById('status').value;
-            
+
             let visibleCount = 0;
             let notAppliedCount = 0;
             let appliedCount = 0;
-            
+
             allJobs.forEach(row => {
                 const title = row.dataset.title.toLowerCase();
                 const rowCompany = row.dataset.company;
                 const rowLocation = row.dataset.location;
                 const rowLevels = row.dataset.levels.toLowerCase();
                 const rowStatus = row.dataset.status;
-                
+
                 let show = true;
-                
-                // Search filter
-                if (search && !title.includes(search) && !rowCompany.toLowerCase().includes(search) && !rowLocation.toLowerCase().includes(search)) {
+
+                // Free-text search
+                if (parsed.freeText && !title.includes(parsed.freeText) && !rowCompany.toLowerCase().includes(parsed.freeText) && !rowLocation.toLowerCase().includes(parsed.freeText)) {
                     show = false;
                 }
-                
+
+                // Tagged search terms
+                if (parsed.tags.title.some(v => !title.includes(v))) show = false;
+                if (parsed.tags.company.some(v => !rowCompany.toLowerCase().includes(v))) show = false;
+                if (parsed.tags.location.some(v => !rowLocation.toLowerCase().includes(v))) show = false;
+                if (parsed.tags.level.some(v => !rowLevels.includes(v))) show = false;
+                parsed.tags.is.forEach(v => {
+                    const wantsApplied = v === 'applied';
+                    const isApplied = rowStatus === 'Applied';
+                    if (wantsApplied !== isApplied) {
+                        show = false;
+                    }
+                });
+
                 // Level filter
                 if (selectedLevels.length > 0) {
                     const matchesLevel = selectedLevels.some(level => rowLevels.includes(level));
@@ -286,6 +718,7 @@ const indexHTML = `<!DOCTYPE html>
         }
         
         // Initialize
+        applySort();
         filterJobs();
     </script>
 </body>
@@ -293,6 +726,8 @@ const indexHTML = `<!DOCTYPE html>
 
 func main() {
 	outDir := flag.String("out", "public", "Output directory for static site")
+	itemsPerPage := flag.Int("items-per-page", 100, "Jobs per generated page")
+	apiBase := flag.String("api-base", "", "Base URL of a live api-server to query against instead of the pre-generated jobs-N.json pages (e.g. https://api.example.com). Leave empty to serve fully static.")
 	flag.Parse()
 
 	logger.InitFromEnv()
@@ -304,12 +739,9 @@ func main() {
 	}
 	defer d.Close()
 
-	// Fetch all jobs
-	rows, err := d.Conn.Query(`SELECT id, title, company, location, type, url, date_added, status FROM job_applications ORDER BY date_added DESC`)
-	if err != nil {
-		logger.Fatal("query jobs: %v", err)
+	if err := os.MkdirAll(*outDir, 0755); err != nil {
+		logger.Fatal("create output dir: %v", err)
 	}
-	defer rows.Close()
 
 	type JobWithLevels struct {
 		Job
@@ -317,49 +749,74 @@ func main() {
 		StatusClass string
 	}
 
-	var jobs []JobWithLevels
+	var allJobs []JobWithLevels
+	var indexJobs []JobWithLevels
+	var indexHasNextPage bool
 	levelSet := map[string]bool{}
 	companySet := map[string]bool{}
 	locationSet := map[string]bool{}
 	notApplied := 0
 	applied := 0
 
-	for rows.Next() {
-		var job Job
-		var typ string
-		if err := rows.Scan(&job.ID, &job.Title, &job.Company, &job.Location, &typ, &job.URL, &job.DateAdded, &job.Status); err != nil {
-			logger.Error("scan row: %v", err)
-			continue
+	ctx := context.Background()
+	pageNum := 0
+	for {
+		jobRows, hasNextPage, err := d.QueryJobs(ctx, db.JobFilter{}, db.PageRequest{Page: pageNum, ItemsPerPage: *itemsPerPage},
+			[]db.OrderField{{Column: db.SortByDate, Direction: db.Descending}})
+		if err != nil {
+			logger.Fatal("query jobs page %d: %v", pageNum, err)
 		}
-		job.Type = typ
 
-		// Derive levels
-		levels := deriveLevels(job.Title)
-		for _, lv := range levels {
-			levelSet[lv] = true
-		}
-		levelsStr := strings.Join(levels, ", ")
-		if levelsStr == "" {
-			levelsStr = "General"
+		var pageJobs []JobWithLevels
+		for _, job := range jobRows {
+			// Derive levels
+			levels := deriveLevels(job.Title)
+			for _, lv := range levels {
+				levelSet[lv] = true
+			}
+			levelsStr := strings.Join(levels, ", ")
+			if levelsStr == "" {
+				levelsStr = "General"
+			}
+
+			statusClass := "not-applied"
+			if job.Status == "Applied" {
+				statusClass = "applied"
+				applied++
+			} else {
+				notApplied++
+			}
+
+			jwl := JobWithLevels{
+				Job:         job,
+				Levels:      levelsStr,
+				StatusClass: statusClass,
+			}
+			pageJobs = append(pageJobs, jwl)
+			companySet[job.Company] = true
+			locationSet[job.Location] = true
 		}
 
-		statusClass := "not-applied"
-		if job.Status == "Applied" {
-			statusClass = "applied"
-			applied++
-		} else {
-			notApplied++
+		if err := writeJSON(filepath.Join(*outDir, fmt.Sprintf("jobs-%d.json", pageNum)), struct {
+			Jobs        []JobWithLevels
+			Page        int
+			HasNextPage bool
+		}{Jobs: pageJobs, Page: pageNum, HasNextPage: hasNextPage}); err != nil {
+			logger.Fatal("write jobs-%d.json: %v", pageNum, err)
 		}
 
-		jobs = append(jobs, JobWithLevels{
-			Job:         job,
-			Levels:      levelsStr,
-			StatusClass: statusClass,
-		})
+		allJobs = append(allJobs, pageJobs...)
+		if pageNum == 0 {
+			indexJobs = pageJobs
+			indexHasNextPage = hasNextPage
+		}
 
-		companySet[job.Company] = true
-		locationSet[job.Location] = true
+		if !hasNextPage {
+			break
+		}
+		pageNum++
 	}
+	jobs := indexJobs
 
 	// Convert sets to sorted slices
 	var levels []string
@@ -384,25 +841,29 @@ func main() {
 	tmpl := template.Must(template.New("index").Parse(indexHTML))
 
 	data := struct {
-		Jobs       []JobWithLevels
-		Levels     []string
-		Companies  []string
-		Locations  []string
-		TotalJobs  int
-		NotApplied int
-		Applied    int
+		Jobs        []JobWithLevels
+		Levels      []string
+		Companies   []string
+		Locations   []string
+		TotalJobs   int
+		NotApplied  int
+		Applied     int
+		CurrentPage int
+		HasPrevPage bool
+		HasNextPage bool
+		APIBase     string
 	}{
-		Jobs:       jobs,
-		Levels:     levels,
-		Companies:  companies,
-		Locations:  locations,
-		TotalJobs:  len(jobs),
-		NotApplied: notApplied,
-		Applied:    applied,
-	}
-
-	if err := os.MkdirAll(*outDir, 0755); err != nil {
-		logger.Fatal("create output dir: %v", err)
+		Jobs:        jobs,
+		Levels:      levels,
+		Companies:   companies,
+		Locations:   locations,
+		TotalJobs:   len(allJobs),
+		NotApplied:  notApplied,
+		Applied:     applied,
+		CurrentPage: 0,
+		HasPrevPage: false,
+		HasNextPage: indexHasNextPage,
+		APIBase:     *apiBase,
 	}
 
 	indexPath := filepath.Join(*outDir, "index.html")
@@ -416,22 +877,63 @@ func main() {
 		logger.Fatal("execute template: %v", err)
 	}
 
-	logger.Info("Generated static site in %s", *outDir)
+	logger.Info("Generated static site in %s with %d page(s)", *outDir, pageNum+1)
+
+	// Also export jobs.json as a combined dump for third-party tools
+	combined := data
+	combined.Jobs = allJobs
+	if err := writeJSON(filepath.Join(*outDir, "jobs.json"), combined); err != nil {
+		logger.Fatal("write jobs.json: %v", err)
+	}
+
+	logger.Info("Generated jobs.json")
 
-	// Also export jobs.json for API access
-	jobsJSON := filepath.Join(*outDir, "jobs.json")
-	jf, err := os.Create(jobsJSON)
+	// Export stats.json for the Trends tab
+	siteStats, err := siteStatistics(ctx, d)
 	if err != nil {
-		logger.Fatal("create jobs.json: %v", err)
+		logger.Fatal("compute statistics: %v", err)
+	}
+	if err := writeJSON(filepath.Join(*outDir, "stats.json"), siteStats); err != nil {
+		logger.Fatal("write stats.json: %v", err)
 	}
-	defer jf.Close()
+	logger.Info("Generated stats.json")
 
-	enc := json.NewEncoder(jf)
-	enc.SetIndent("", "  ")
-	if err := enc.Encode(data); err != nil {
-		logger.Fatal("encode json: %v", err)
+	logger.Info("Site ready with %d jobs", len(allJobs))
+}
+
+// siteStatistics groups the unfiltered job set by company, location, and
+// level for the generated site's Trends tab.
+type siteStats struct {
+	ByCompany  *db.Statistics
+	ByLocation *db.Statistics
+	ByLevel    *db.Statistics
+}
+
+func siteStatistics(ctx context.Context, d *db.DB) (*siteStats, error) {
+	byCompany, err := d.JobsStatistics(ctx, db.JobFilter{}, db.GroupByCompany, db.BucketWeek)
+	if err != nil {
+		return nil, fmt.Errorf("by company: %w", err)
+	}
+	byLocation, err := d.JobsStatistics(ctx, db.JobFilter{}, db.GroupByLocation, db.BucketWeek)
+	if err != nil {
+		return nil, fmt.Errorf("by location: %w", err)
+	}
+	byLevel, err := d.JobsStatistics(ctx, db.JobFilter{}, db.GroupByLevel, db.BucketWeek)
+	if err != nil {
+		return nil, fmt.Errorf("by level: %w", err)
 	}
+	return &siteStats{ByCompany: byCompany, ByLocation: byLocation, ByLevel: byLevel}, nil
+}
 
-	logger.Info("Generated jobs.json")
-	logger.Info("Site ready with %d jobs", len(jobs))
+// writeJSON writes v as indented JSON to path.
+func writeJSON(path string, v interface{}) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
 }
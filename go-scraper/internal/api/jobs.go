@@ -0,0 +1,169 @@
+// Package api exposes the job tracker's data over HTTP/JSON so the static
+// site and third-party tools can query job_applications without loading
+// every row into the DOM.
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/ajiteshreddy7/yc-go-scraper/internal/db"
+)
+
+// Server serves the jobs query API on top of a *db.DB.
+type Server struct {
+	DB *db.DB
+}
+
+// NewServer builds a Server backed by d.
+func NewServer(d *db.DB) *Server {
+	return &Server{DB: d}
+}
+
+// Handler returns the mux serving the API's routes, wrapped so cross-origin
+// callers can reach it - the generated static site is typically served from
+// a different origin than this API, and so is any other third-party tool.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/jobs", s.handleQueryJobs)
+	mux.HandleFunc("/api/stats", s.handleJobsStatistics)
+	return withCORS(mux)
+}
+
+// withCORS allows any origin to make (pre-flighted) POST requests against h.
+func withCORS(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+		if r.Method == http.MethodOptions {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+// queryJobsRequest is the POST body accepted by /api/jobs. Search, if set,
+// is parsed via db.ParseSearchQuery and takes precedence over Filter - it
+// exists so callers can send the same tag-prefixed query the UI's search
+// box accepts (e.g. "company:openai level:intern") instead of building a
+// JobFilter by hand.
+type queryJobsRequest struct {
+	Filter       db.JobFilter    `json:"filter"`
+	Search       string          `json:"search"`
+	Page         int             `json:"page"`
+	ItemsPerPage int             `json:"itemsPerPage"`
+	Order        []db.OrderField `json:"order"`
+}
+
+// jobResponse annotates a db.Job with its derived Levels and StatusClass, so
+// a caller rendering a table (e.g. the generated static site's JS) has the
+// same fields it already gets from the pre-generated jobs-N.json pages,
+// without having to re-derive levels itself.
+type jobResponse struct {
+	db.Job
+	Levels      string `json:"Levels"`
+	StatusClass string `json:"StatusClass"`
+}
+
+// queryJobsResponse is the JSON payload returned by /api/jobs.
+type queryJobsResponse struct {
+	Jobs        []jobResponse `json:"jobs"`
+	HasNextPage bool          `json:"hasNextPage"`
+}
+
+const defaultItemsPerPage = 50
+
+// statusClass returns the CSS class the static site uses to color a job's
+// status.
+func statusClass(status string) string {
+	if status == "Applied" {
+		return "applied"
+	}
+	return "not-applied"
+}
+
+// handleQueryJobs accepts a POST body containing a db.JobFilter plus paging
+// info and responds with the matching page of jobs as JSON.
+func (s *Server) handleQueryJobs(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req queryJobsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "invalid request: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if req.Page < 0 {
+		http.Error(w, "invalid request: page must be >= 0", http.StatusBadRequest)
+		return
+	}
+	if req.ItemsPerPage < 0 {
+		http.Error(w, "invalid request: itemsPerPage must be >= 0", http.StatusBadRequest)
+		return
+	}
+	if req.ItemsPerPage == 0 {
+		req.ItemsPerPage = defaultItemsPerPage
+	}
+	filter := req.Filter
+	if req.Search != "" {
+		filter = db.ParseSearchQuery(req.Search)
+	}
+
+	jobs, hasNextPage, err := s.DB.QueryJobs(r.Context(), filter, db.PageRequest{Page: req.Page, ItemsPerPage: req.ItemsPerPage}, req.Order)
+	if err != nil {
+		http.Error(w, "query jobs: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	resp := make([]jobResponse, len(jobs))
+	for i, j := range jobs {
+		levels := strings.Join(db.DeriveLevels(j.Title), ", ")
+		if levels == "" {
+			levels = "General"
+		}
+		resp[i] = jobResponse{Job: j, Levels: levels, StatusClass: statusClass(j.Status)}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(queryJobsResponse{Jobs: resp, HasNextPage: hasNextPage}); err != nil {
+		http.Error(w, "encode response: "+err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// statisticsRequest is the POST body accepted by /api/stats.
+type statisticsRequest struct {
+	Filter  db.JobFilter  `json:"filter"`
+	GroupBy db.GroupBy    `json:"groupBy"`
+	Bucket  db.TimeBucket `json:"bucket"`
+}
+
+// handleJobsStatistics accepts a POST body containing a db.JobFilter plus a
+// groupBy/bucket and responds with the aggregated db.Statistics as JSON.
+func (s *Server) handleJobsStatistics(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req statisticsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "invalid request: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	stats, err := s.DB.JobsStatistics(r.Context(), req.Filter, req.GroupBy, req.Bucket)
+	if err != nil {
+		http.Error(w, "jobs statistics: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(stats); err != nil {
+		http.Error(w, "encode response: "+err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -3,6 +3,8 @@ package db
 import (
 	"database/sql"
 	"os"
+	"regexp"
+	"strings"
 
 	_ "github.com/lib/pq"
 )
@@ -31,7 +33,11 @@ func (d *DB) Close() error {
 	return d.Conn.Close()
 }
 
-// CreateSchema creates the job_applications table if not exists
+// CreateSchema creates the job_applications and job_levels tables if they
+// don't already exist, then backfills job_levels for any job_applications
+// row left over from before job_levels existed. job_levels precomputes the
+// labels deriveLevels would otherwise have to re-derive from title text, so
+// grouping/filtering by level is a plain join instead of a string search.
 func (d *DB) CreateSchema() error {
 	q := `
     CREATE TABLE IF NOT EXISTS job_applications (
@@ -45,22 +51,146 @@ func (d *DB) CreateSchema() error {
         date_added TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
         status TEXT DEFAULT 'Not Applied'
     );
+    CREATE TABLE IF NOT EXISTS job_levels (
+        job_id INT NOT NULL REFERENCES job_applications(id) ON DELETE CASCADE,
+        level TEXT NOT NULL,
+        PRIMARY KEY (job_id, level)
+    );
     `
-	_, err := d.Conn.Exec(q)
-	return err
+	if _, err := d.Conn.Exec(q); err != nil {
+		return err
+	}
+	return d.backfillJobLevels()
+}
+
+// backfillJobLevels derives and stores job_levels rows for any
+// job_applications row that doesn't have one yet. Rows inserted before
+// job_levels existed would otherwise sit without levels forever, since
+// setJobLevels only ever runs on insert/upsert.
+func (d *DB) backfillJobLevels() error {
+	rows, err := d.Conn.Query(`
+        SELECT id, title FROM job_applications
+        WHERE NOT EXISTS (SELECT 1 FROM job_levels WHERE job_levels.job_id = job_applications.id);
+    `)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	type jobTitle struct {
+		id    int
+		title string
+	}
+	var missing []jobTitle
+	for rows.Next() {
+		var jt jobTitle
+		if err := rows.Scan(&jt.id, &jt.title); err != nil {
+			return err
+		}
+		missing = append(missing, jt)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, jt := range missing {
+		if err := d.setJobLevels(jt.id, jt.title); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // InsertJob inserts a job record, ignores duplicate URL errors
 func (d *DB) InsertJob(job map[string]interface{}) error {
 	// job expected keys: Title, Company, Location, URL, Type
-	q := `INSERT INTO job_applications(title, company, location, type, url) VALUES($1,$2,$3,$4,$5) ON CONFLICT (url) DO NOTHING;`
-	_, err := d.Conn.Exec(q, job["Title"], job["Company"], job["Location"], job["Type"], job["URL"])
-	return err
+	title, _ := job["Title"].(string)
+	return d.InsertJobTyped(title, toString(job["Company"]), toString(job["Location"]), toString(job["Type"]), toString(job["URL"]))
 }
 
-// InsertJob using typed Job from scraper
+func toString(v interface{}) string {
+	s, _ := v.(string)
+	return s
+}
+
+// InsertJobTyped inserts a job from the scraper and precomputes its
+// job_levels rows from the title, so grouping/filtering by level never has
+// to re-derive it from text.
 func (d *DB) InsertJobTyped(title, company, location, typ, url string) error {
-	q := `INSERT INTO job_applications(title, company, location, type, url) VALUES($1,$2,$3,$4,$5) ON CONFLICT (url) DO NOTHING;`
-	_, err := d.Conn.Exec(q, title, company, location, typ, url)
-	return err
+	q := `INSERT INTO job_applications(title, company, location, type, url) VALUES($1,$2,$3,$4,$5)
+        ON CONFLICT (url) DO UPDATE SET title = EXCLUDED.title
+        RETURNING id;`
+	var id int
+	if err := d.Conn.QueryRow(q, title, company, location, typ, url).Scan(&id); err != nil {
+		return err
+	}
+	return d.setJobLevels(id, title)
+}
+
+// setJobLevels replaces job_id's job_levels rows with those derived from
+// title, so re-scraping an existing job keeps its levels in sync.
+func (d *DB) setJobLevels(jobID int, title string) error {
+	if _, err := d.Conn.Exec(`DELETE FROM job_levels WHERE job_id = $1;`, jobID); err != nil {
+		return err
+	}
+	for _, level := range DeriveLevels(title) {
+		if _, err := d.Conn.Exec(`INSERT INTO job_levels(job_id, level) VALUES($1, $2) ON CONFLICT DO NOTHING;`, jobID, level); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeriveLevels returns the canonical level labels found in a job title
+// (e.g. "Intern", "New Grad", "Entry Level"). It is the single source of
+// truth for level labels: ingest precomputes it into job_levels, and
+// QueryJobs/JobsStatistics filter and group on that table instead of
+// re-deriving it from title text.
+func DeriveLevels(title string) []string {
+	t := strings.ToLower(title)
+	var out []string
+	add := func(s string) { out = append(out, s) }
+	if matched, _ := regexp.MatchString(`\bintern(ship)?\b`, t); matched {
+		add("Intern")
+	}
+	if strings.Contains(t, "new grad") || strings.Contains(t, "new graduate") {
+		add("New Grad")
+	}
+	if strings.Contains(t, "entry level") || strings.Contains(t, "entry-level") {
+		add("Entry Level")
+	}
+	if strings.Contains(t, "junior") {
+		add("Junior")
+	}
+	if strings.Contains(t, "associate") {
+		add("Associate")
+	}
+	if strings.Contains(t, "apprentice") {
+		add("Apprentice")
+	}
+	if strings.Contains(t, "fellow") {
+		add("Fellow")
+	}
+	if strings.Contains(t, "co-op") || strings.Contains(t, "co op") || strings.Contains(t, "coop") {
+		add("Co-op")
+	}
+	if len(out) == 0 {
+		if matched, _ := regexp.MatchString(`\b(engineer|developer|analyst|specialist|coordinator)\b`, t); matched {
+			if ok, _ := regexp.MatchString(`\b(senior|staff|principal|lead|manager|director|architect|head|chief|vp)\b`, t); !ok {
+				add("Entry Level")
+			}
+		}
+	}
+	if len(out) > 1 {
+		seen := map[string]bool{}
+		uniq := []string{}
+		for _, v := range out {
+			if !seen[v] {
+				seen[v] = true
+				uniq = append(uniq, v)
+			}
+		}
+		out = uniq
+	}
+	return out
 }
@@ -0,0 +1,30 @@
+package db
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDeriveLevels(t *testing.T) {
+	tests := []struct {
+		name  string
+		title string
+		want  []string
+	}{
+		{name: "intern", title: "Software Engineering Intern", want: []string{"Intern"}},
+		{name: "new grad", title: "New Grad Software Engineer", want: []string{"New Grad"}},
+		{name: "entry level falls out of engineer title with no seniority", title: "Backend Engineer", want: []string{"Entry Level"}},
+		{name: "senior engineer has no inferred level", title: "Senior Backend Engineer", want: nil},
+		{name: "hyphenated co-op spelling matches", title: "Firmware Co-op", want: []string{"Co-op"}},
+		{name: "multiple explicit levels keep order", title: "Intern / New Grad Data Analyst", want: []string{"Intern", "New Grad"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DeriveLevels(tt.title)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DeriveLevels(%q) = %v, want %v", tt.title, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,284 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Job mirrors a row of job_applications, as returned by QueryJobs.
+type Job struct {
+	ID        int
+	Title     string
+	Company   string
+	Location  string
+	Type      string
+	URL       string
+	DateAdded time.Time
+	Status    string
+}
+
+// StringInput describes a single-field string match. Exactly one of its
+// fields should be set; if more than one is set, they are ANDed together.
+type StringInput struct {
+	Eq         *string
+	Contains   *string
+	StartsWith *string
+	EndsWith   *string
+	In         []string
+}
+
+// TimeRange bounds a timestamp column. Either end may be left nil to leave
+// that side of the range open.
+type TimeRange struct {
+	From *time.Time
+	To   *time.Time
+}
+
+// JobFilter is a structured filter over job_applications, compiled into
+// parameterized SQL by QueryJobs. A nil field means "no constraint".
+type JobFilter struct {
+	Title     *StringInput
+	Company   *StringInput
+	Location  *StringInput
+	Level     *StringInput
+	Status    *StringInput
+	DateAdded *TimeRange
+	// FreeText, if set, matches jobs whose title, company, or location
+	// contains the text. Populated by ParseSearchQuery from untagged terms.
+	FreeText *string
+}
+
+// queryBuilder accumulates WHERE clauses and their positional args so SQL
+// text and argument order never drift apart.
+type queryBuilder struct {
+	clauses []string
+	args    []interface{}
+}
+
+func (b *queryBuilder) push(format string, arg interface{}) {
+	b.args = append(b.args, arg)
+	b.clauses = append(b.clauses, fmt.Sprintf(format, len(b.args)))
+}
+
+// string adds the constraints of in, if any, against column.
+func (b *queryBuilder) string(column string, in *StringInput) {
+	if in == nil {
+		return
+	}
+	if in.Eq != nil {
+		b.push(column+" = $%d", *in.Eq)
+	}
+	if in.Contains != nil {
+		b.push(column+" ILIKE $%d", "%"+*in.Contains+"%")
+	}
+	if in.StartsWith != nil {
+		b.push(column+" ILIKE $%d", *in.StartsWith+"%")
+	}
+	if in.EndsWith != nil {
+		b.push(column+" ILIKE $%d", "%"+*in.EndsWith)
+	}
+	if len(in.In) > 0 {
+		placeholders := make([]string, len(in.In))
+		for i, v := range in.In {
+			b.args = append(b.args, v)
+			placeholders[i] = fmt.Sprintf("$%d", len(b.args))
+		}
+		b.clauses = append(b.clauses, fmt.Sprintf("%s IN (%s)", column, strings.Join(placeholders, ", ")))
+	}
+}
+
+// level adds a constraint on in against job_levels, wrapped in an EXISTS
+// subquery so a job with multiple levels isn't duplicated in the result set
+// the way a plain JOIN would duplicate it.
+func (b *queryBuilder) level(in *StringInput) {
+	if in == nil {
+		return
+	}
+	start := len(b.clauses)
+	b.string("jl.level", in)
+	inner := b.clauses[start:]
+	if len(inner) == 0 {
+		return
+	}
+	b.clauses = append(b.clauses[:start], fmt.Sprintf(
+		"EXISTS (SELECT 1 FROM job_levels jl WHERE jl.job_id = job_applications.id AND %s)",
+		strings.Join(inner, " AND ")))
+}
+
+// freeText adds an OR'd ILIKE match across title, company, and location.
+func (b *queryBuilder) freeText(q *string) {
+	if q == nil || *q == "" {
+		return
+	}
+	like := "%" + *q + "%"
+	var parts []string
+	for _, column := range []string{"title", "company", "location"} {
+		b.args = append(b.args, like)
+		parts = append(parts, fmt.Sprintf("%s ILIKE $%d", column, len(b.args)))
+	}
+	b.clauses = append(b.clauses, "("+strings.Join(parts, " OR ")+")")
+}
+
+func (b *queryBuilder) timeRange(column string, r *TimeRange) {
+	if r == nil {
+		return
+	}
+	if r.From != nil {
+		b.push(column+" >= $%d", *r.From)
+	}
+	if r.To != nil {
+		b.push(column+" <= $%d", *r.To)
+	}
+}
+
+func (b *queryBuilder) where() string {
+	if len(b.clauses) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(b.clauses, " AND ")
+}
+
+// PageRequest selects a 0-indexed page of ItemsPerPage rows.
+type PageRequest struct {
+	Page         int
+	ItemsPerPage int
+}
+
+// SortDirection is the direction of an OrderField.
+type SortDirection string
+
+const (
+	Ascending  SortDirection = "asc"
+	Descending SortDirection = "desc"
+)
+
+// SortColumn is a sortable column of job_applications.
+type SortColumn string
+
+const (
+	SortByDate     SortColumn = "date"
+	SortByCompany  SortColumn = "company"
+	SortByTitle    SortColumn = "title"
+	SortByLocation SortColumn = "location"
+	SortByLevel    SortColumn = "level"
+	SortByStatus   SortColumn = "status"
+)
+
+// sortColumnSQL maps a SortColumn to the SQL expression it sorts on. Level
+// has no column of its own; a job can have several, so it sorts by the
+// alphabetically-first one via a correlated subquery against job_levels.
+var sortColumnSQL = map[SortColumn]string{
+	SortByDate:     "date_added",
+	SortByCompany:  "company",
+	SortByTitle:    "title",
+	SortByLocation: "location",
+	SortByStatus:   "status",
+	SortByLevel:    "(SELECT MIN(level) FROM job_levels WHERE job_levels.job_id = job_applications.id)",
+}
+
+// OrderField is one key of a multi-column ORDER BY; QueryJobs sorts by
+// each in sequence, so a second OrderField breaks ties in the first.
+type OrderField struct {
+	Column    SortColumn
+	Direction SortDirection
+}
+
+// orderByClause compiles order into an ORDER BY clause with NULLS LAST, so
+// jobs missing the sorted field (e.g. no derived level) sort after the rest
+// instead of before. An empty order defaults to newest-first.
+func orderByClause(order []OrderField) (string, error) {
+	if len(order) == 0 {
+		order = []OrderField{{Column: SortByDate, Direction: Descending}}
+	}
+	parts := make([]string, len(order))
+	for i, o := range order {
+		col, ok := sortColumnSQL[o.Column]
+		if !ok {
+			return "", fmt.Errorf("query jobs: unknown sort column %q", o.Column)
+		}
+		dir := "ASC"
+		if o.Direction == Descending {
+			dir = "DESC"
+		}
+		parts[i] = fmt.Sprintf("%s %s NULLS LAST", col, dir)
+	}
+	return " ORDER BY " + strings.Join(parts, ", "), nil
+}
+
+func (b *queryBuilder) clone() *queryBuilder {
+	clauses := make([]string, len(b.clauses))
+	copy(clauses, b.clauses)
+	args := make([]interface{}, len(b.args))
+	copy(args, b.args)
+	return &queryBuilder{clauses: clauses, args: args}
+}
+
+// QueryJobs runs filter against job_applications and returns the rows for
+// the requested page sorted by order, plus whether a subsequent page (under
+// the same sort) has any rows. Level is matched against the precomputed
+// job_levels table rather than re-deriving it from title text.
+//
+// hasNextPage is answered with a second, minimal query rather than a
+// COUNT(*) over the whole filtered set: it asks for a single row at the
+// start of the next page (offset (page.Page+1)*page.ItemsPerPage) and
+// reports whether one came back.
+func (d *DB) QueryJobs(ctx context.Context, filter JobFilter, page PageRequest, order []OrderField) (jobs []Job, hasNextPage bool, err error) {
+	orderBy, err := orderByClause(order)
+	if err != nil {
+		return nil, false, err
+	}
+
+	b := &queryBuilder{}
+	b.string("title", filter.Title)
+	b.string("company", filter.Company)
+	b.string("location", filter.Location)
+	b.string("status", filter.Status)
+	b.timeRange("date_added", filter.DateAdded)
+	b.level(filter.Level)
+	b.freeText(filter.FreeText)
+	where := b.where()
+
+	pageBuilder := b.clone()
+	pageBuilder.push("OFFSET $%d", page.Page*page.ItemsPerPage)
+	offsetClause := pageBuilder.clauses[len(pageBuilder.clauses)-1]
+	pageBuilder.push("LIMIT $%d", page.ItemsPerPage)
+	limitClause := pageBuilder.clauses[len(pageBuilder.clauses)-1]
+
+	q := "SELECT id, title, company, location, type, url, date_added, status FROM job_applications" +
+		where + orderBy + " " + limitClause + " " + offsetClause
+
+	rows, err := d.Conn.QueryContext(ctx, q, pageBuilder.args...)
+	if err != nil {
+		return nil, false, fmt.Errorf("query jobs: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var j Job
+		if err := rows.Scan(&j.ID, &j.Title, &j.Company, &j.Location, &j.Type, &j.URL, &j.DateAdded, &j.Status); err != nil {
+			return nil, false, fmt.Errorf("scan job: %w", err)
+		}
+		jobs = append(jobs, j)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, fmt.Errorf("iterate jobs: %w", err)
+	}
+
+	nextBuilder := b.clone()
+	nextBuilder.push("OFFSET $%d", (page.Page+1)*page.ItemsPerPage)
+	nextOffsetClause := nextBuilder.clauses[len(nextBuilder.clauses)-1]
+	nextQ := "SELECT id FROM job_applications" + where + orderBy + " LIMIT 1 " + nextOffsetClause
+	nextRows, err := d.Conn.QueryContext(ctx, nextQ, nextBuilder.args...)
+	if err != nil {
+		return nil, false, fmt.Errorf("query next page: %w", err)
+	}
+	defer nextRows.Close()
+	hasNextPage = nextRows.Next()
+	if err := nextRows.Err(); err != nil {
+		return nil, false, fmt.Errorf("iterate next page: %w", err)
+	}
+
+	return jobs, hasNextPage, nil
+}
@@ -0,0 +1,130 @@
+package db
+
+import (
+	"strings"
+	"testing"
+)
+
+func strPtr(s string) *string { return &s }
+
+func TestQueryBuilderString(t *testing.T) {
+	tests := []struct {
+		name      string
+		in        *StringInput
+		wantArgs  []interface{}
+		wantWhere string
+	}{
+		{
+			name:      "nil input adds nothing",
+			in:        nil,
+			wantArgs:  nil,
+			wantWhere: "",
+		},
+		{
+			name:      "eq",
+			in:        &StringInput{Eq: strPtr("Applied")},
+			wantArgs:  []interface{}{"Applied"},
+			wantWhere: " WHERE status = $1",
+		},
+		{
+			name:      "contains wraps value in percent signs",
+			in:        &StringInput{Contains: strPtr("engineer")},
+			wantArgs:  []interface{}{"%engineer%"},
+			wantWhere: " WHERE status ILIKE $1",
+		},
+		{
+			name:      "in expands to one placeholder per value",
+			in:        &StringInput{In: []string{"a", "b"}},
+			wantArgs:  []interface{}{"a", "b"},
+			wantWhere: " WHERE status IN ($1, $2)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &queryBuilder{}
+			b.string("status", tt.in)
+			if got := b.where(); got != tt.wantWhere {
+				t.Errorf("where() = %q, want %q", got, tt.wantWhere)
+			}
+			if len(b.args) != len(tt.wantArgs) {
+				t.Fatalf("args = %v, want %v", b.args, tt.wantArgs)
+			}
+			for i, a := range tt.wantArgs {
+				if b.args[i] != a {
+					t.Errorf("args[%d] = %v, want %v", i, b.args[i], a)
+				}
+			}
+		})
+	}
+}
+
+func TestQueryBuilderLevel(t *testing.T) {
+	b := &queryBuilder{}
+	b.level(&StringInput{Eq: strPtr("Intern")})
+
+	where := b.where()
+	if !strings.Contains(where, "EXISTS (SELECT 1 FROM job_levels jl") {
+		t.Fatalf("level() did not wrap its clause in an EXISTS subquery: %q", where)
+	}
+	if !strings.Contains(where, "jl.level = $1") {
+		t.Fatalf("level() clause missing jl.level match: %q", where)
+	}
+	if len(b.args) != 1 || b.args[0] != "Intern" {
+		t.Fatalf("args = %v, want [Intern]", b.args)
+	}
+}
+
+func TestQueryBuilderLevelNil(t *testing.T) {
+	b := &queryBuilder{}
+	b.level(nil)
+	if where := b.where(); where != "" {
+		t.Fatalf("level(nil) should add no clause, got %q", where)
+	}
+}
+
+func TestOrderByClause(t *testing.T) {
+	tests := []struct {
+		name  string
+		order []OrderField
+		want  string
+	}{
+		{
+			name:  "empty order defaults to newest first",
+			order: nil,
+			want:  " ORDER BY date_added DESC NULLS LAST",
+		},
+		{
+			name:  "single column ascending",
+			order: []OrderField{{Column: SortByCompany, Direction: Ascending}},
+			want:  " ORDER BY company ASC NULLS LAST",
+		},
+		{
+			name: "multiple columns break ties in order",
+			order: []OrderField{
+				{Column: SortByCompany, Direction: Ascending},
+				{Column: SortByDate, Direction: Descending},
+			},
+			want: " ORDER BY company ASC NULLS LAST, date_added DESC NULLS LAST",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := orderByClause(tt.order)
+			if err != nil {
+				t.Fatalf("orderByClause() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("orderByClause() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderByClauseUnknownColumn(t *testing.T) {
+	_, err := orderByClause([]OrderField{{Column: SortColumn("bogus"), Direction: Ascending}})
+	if err == nil {
+		t.Fatal("orderByClause() with an unknown column should error")
+	}
+}
@@ -0,0 +1,103 @@
+package db
+
+import "strings"
+
+// searchTagPrefixes are the recognized "prefix:value" tags in a search
+// query (e.g. "company:openai"). An unrecognized prefix degrades to a
+// plain substring match on the whole token, same as untagged text.
+var searchTagPrefixes = map[string]bool{
+	"title":    true,
+	"company":  true,
+	"location": true,
+	"loc":      true,
+	"level":    true,
+	"is":       true,
+}
+
+// tokenizeSearchQuery splits q on whitespace, treating a double-quoted
+// span as one token (so title:"ml engineer" keeps its value together).
+func tokenizeSearchQuery(q string) []string {
+	var tokens []string
+	var cur strings.Builder
+	inQuotes := false
+	for _, r := range q {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+		case r == ' ' && !inQuotes:
+			if cur.Len() > 0 {
+				tokens = append(tokens, cur.String())
+				cur.Reset()
+			}
+		default:
+			cur.WriteRune(r)
+		}
+	}
+	if cur.Len() > 0 {
+		tokens = append(tokens, cur.String())
+	}
+	return tokens
+}
+
+// ParseSearchQuery parses a tag-prefixed search query (title:, company:,
+// level:, loc:/location:, is:applied/is:not-applied) into a JobFilter,
+// folding any remaining free text into FreeText for a full-text match
+// across title/company/location.
+func ParseSearchQuery(query string) JobFilter {
+	var filter JobFilter
+	var freeText []string
+
+	contains := func(existing *StringInput, value string) *StringInput {
+		if existing == nil {
+			existing = &StringInput{}
+		}
+		v := value
+		existing.Contains = &v
+		return existing
+	}
+
+	for _, tok := range tokenizeSearchQuery(query) {
+		idx := strings.Index(tok, ":")
+		if idx <= 0 {
+			freeText = append(freeText, tok)
+			continue
+		}
+		prefix := strings.ToLower(tok[:idx])
+		value := tok[idx+1:]
+		if !searchTagPrefixes[prefix] {
+			freeText = append(freeText, tok)
+			continue
+		}
+
+		switch prefix {
+		case "title":
+			filter.Title = contains(filter.Title, value)
+		case "company":
+			filter.Company = contains(filter.Company, value)
+		case "location", "loc":
+			filter.Location = contains(filter.Location, value)
+		case "level":
+			filter.Level = contains(filter.Level, value)
+		case "is":
+			switch {
+			case strings.EqualFold(value, "applied"):
+				status := "Applied"
+				filter.Status = &StringInput{Eq: &status}
+			case strings.EqualFold(value, "not-applied") || strings.EqualFold(value, "notapplied"):
+				status := "Not Applied"
+				filter.Status = &StringInput{Eq: &status}
+			default:
+				// Unrecognized is: value - degrade to free text, same as an
+				// unrecognized prefix, instead of silently matching Applied.
+				freeText = append(freeText, tok)
+			}
+		}
+	}
+
+	if len(freeText) > 0 {
+		text := strings.Join(freeText, " ")
+		filter.FreeText = &text
+	}
+
+	return filter
+}
@@ -0,0 +1,65 @@
+package db
+
+import "testing"
+
+func TestTokenizeSearchQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{name: "empty", query: "", want: nil},
+		{name: "plain words", query: "openai intern", want: []string{"openai", "intern"}},
+		{name: "quoted value keeps its spaces", query: `title:"ml engineer" level:intern`, want: []string{"title:ml engineer", "level:intern"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tokenizeSearchQuery(tt.query)
+			if len(got) != len(tt.want) {
+				t.Fatalf("tokenizeSearchQuery(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("token[%d] = %q, want %q", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestParseSearchQueryTags(t *testing.T) {
+	filter := ParseSearchQuery(`company:openai level:intern is:not-applied`)
+
+	if filter.Company == nil || filter.Company.Contains == nil || *filter.Company.Contains != "openai" {
+		t.Errorf("Company = %+v, want Contains \"openai\"", filter.Company)
+	}
+	if filter.Level == nil || filter.Level.Contains == nil || *filter.Level.Contains != "intern" {
+		t.Errorf("Level = %+v, want Contains \"intern\"", filter.Level)
+	}
+	if filter.Status == nil || filter.Status.Eq == nil || *filter.Status.Eq != "Not Applied" {
+		t.Errorf("Status = %+v, want Eq \"Not Applied\"", filter.Status)
+	}
+	if filter.FreeText != nil {
+		t.Errorf("FreeText = %v, want nil", *filter.FreeText)
+	}
+}
+
+func TestParseSearchQueryUnrecognizedIsValueFallsBackToFreeText(t *testing.T) {
+	filter := ParseSearchQuery("is:appplied")
+
+	if filter.Status != nil {
+		t.Errorf("Status = %+v, want nil - an unrecognized is: value must not default to Applied", filter.Status)
+	}
+	if filter.FreeText == nil || *filter.FreeText != "is:appplied" {
+		t.Errorf("FreeText = %v, want \"is:appplied\"", filter.FreeText)
+	}
+}
+
+func TestParseSearchQueryUnrecognizedPrefixFallsBackToFreeText(t *testing.T) {
+	filter := ParseSearchQuery("salary:150k remote")
+
+	if filter.FreeText == nil || *filter.FreeText != "salary:150k remote" {
+		t.Errorf("FreeText = %v, want \"salary:150k remote\"", filter.FreeText)
+	}
+}
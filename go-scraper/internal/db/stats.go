@@ -0,0 +1,157 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// GroupBy selects the dimension JobsStatistics groups by.
+type GroupBy string
+
+const (
+	GroupByCompany  GroupBy = "company"
+	GroupByLocation GroupBy = "location"
+	GroupByLevel    GroupBy = "level"
+)
+
+// TimeBucket selects the granularity of the JobsStatistics histogram.
+type TimeBucket string
+
+const (
+	BucketDay   TimeBucket = "day"
+	BucketWeek  TimeBucket = "week"
+	BucketMonth TimeBucket = "month"
+)
+
+// dateTruncUnits whitelists the Postgres date_trunc units a TimeBucket may
+// compile to, since date_trunc's unit argument can't be parameterized.
+var dateTruncUnits = map[TimeBucket]string{
+	BucketDay:   "day",
+	BucketWeek:  "week",
+	BucketMonth: "month",
+}
+
+// GroupStats holds the counts for a single group (e.g. one company).
+type GroupStats struct {
+	Group          string
+	TotalJobs      int
+	AppliedJobs    int
+	NotAppliedJobs int
+	NewThisWeek    int
+}
+
+// HistogramBucket holds the job count for a single time bucket.
+type HistogramBucket struct {
+	BucketStart time.Time
+	Count       int
+}
+
+// Statistics is the result of JobsStatistics: per-group counts plus a
+// date_added histogram over the same filtered set.
+type Statistics struct {
+	Groups    []GroupStats
+	Histogram []HistogramBucket
+}
+
+// groupColumn returns the SQL expression to group by, and whether it
+// requires joining job_levels.
+func groupColumn(groupBy GroupBy) (column string, joinLevels bool, err error) {
+	switch groupBy {
+	case GroupByCompany:
+		return "company", false, nil
+	case GroupByLocation:
+		return "location", false, nil
+	case GroupByLevel:
+		return "jl.level", true, nil
+	default:
+		return "", false, fmt.Errorf("jobs statistics: unknown group by %q", groupBy)
+	}
+}
+
+// JobsStatistics returns per-group counts (total/applied/not-applied/new
+// this week) and a date_added histogram over filter, both computed
+// server-side rather than by scanning every row into the caller. Grouping
+// by Level joins the precomputed job_levels table instead of re-deriving
+// levels from title text.
+func (d *DB) JobsStatistics(ctx context.Context, filter JobFilter, groupBy GroupBy, bucket TimeBucket) (*Statistics, error) {
+	if bucket == "" {
+		bucket = BucketWeek
+	}
+	truncUnit, ok := dateTruncUnits[bucket]
+	if !ok {
+		return nil, fmt.Errorf("jobs statistics: unknown time bucket %q", bucket)
+	}
+
+	groupCol, joinLevels, err := groupColumn(groupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &queryBuilder{}
+	b.string("title", filter.Title)
+	b.string("company", filter.Company)
+	b.string("location", filter.Location)
+	b.string("status", filter.Status)
+	b.timeRange("date_added", filter.DateAdded)
+	b.level(filter.Level)
+	b.freeText(filter.FreeText)
+	where := b.where()
+
+	from := "job_applications"
+	if joinLevels {
+		from += " JOIN job_levels jl ON jl.job_id = job_applications.id"
+	}
+
+	groupsQ := fmt.Sprintf(`SELECT %s AS grp,
+		COUNT(*) AS total,
+		COUNT(*) FILTER (WHERE status = 'Applied') AS applied,
+		COUNT(*) FILTER (WHERE status != 'Applied') AS not_applied,
+		COUNT(*) FILTER (WHERE date_added >= now() - interval '7 days') AS new_this_week
+		FROM %s%s
+		GROUP BY %s
+		ORDER BY total DESC`, groupCol, from, where, groupCol)
+
+	rows, err := d.Conn.QueryContext(ctx, groupsQ, b.args...)
+	if err != nil {
+		return nil, fmt.Errorf("jobs statistics: query groups: %w", err)
+	}
+	defer rows.Close()
+
+	var groups []GroupStats
+	for rows.Next() {
+		var g GroupStats
+		if err := rows.Scan(&g.Group, &g.TotalJobs, &g.AppliedJobs, &g.NotAppliedJobs, &g.NewThisWeek); err != nil {
+			return nil, fmt.Errorf("jobs statistics: scan group: %w", err)
+		}
+		groups = append(groups, g)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("jobs statistics: iterate groups: %w", err)
+	}
+
+	histQ := fmt.Sprintf(`SELECT date_trunc('%s', date_added) AS bucket, COUNT(*)
+		FROM %s%s
+		GROUP BY bucket
+		ORDER BY bucket`, truncUnit, from, where)
+
+	histRows, err := d.Conn.QueryContext(ctx, histQ, b.args...)
+	if err != nil {
+		return nil, fmt.Errorf("jobs statistics: query histogram: %w", err)
+	}
+	defer histRows.Close()
+
+	var histogram []HistogramBucket
+	for histRows.Next() {
+		var hb HistogramBucket
+		if err := histRows.Scan(&hb.BucketStart, &hb.Count); err != nil {
+			return nil, fmt.Errorf("jobs statistics: scan histogram bucket: %w", err)
+		}
+		histogram = append(histogram, hb)
+	}
+	if err := histRows.Err(); err != nil {
+		return nil, fmt.Errorf("jobs statistics: iterate histogram: %w", err)
+	}
+
+	return &Statistics{Groups: groups, Histogram: histogram}, nil
+}
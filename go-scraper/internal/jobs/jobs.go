@@ -0,0 +1,69 @@
+// Package jobs turns the one-shot scraper and site generator into a
+// long-running service: a Scheduler decides when a task type should next
+// run, a Worker does the work, and a Registry maps task type names (as
+// stored in scheduled_tasks.type) to the Worker that handles them.
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Scheduler computes the next time a recurring task should run, given the
+// time it just ran (or was first scheduled) at.
+type Scheduler interface {
+	Next(now time.Time) time.Time
+}
+
+// IntervalScheduler runs a task every Every, starting from whenever it last
+// ran (or was first scheduled).
+type IntervalScheduler struct {
+	Every time.Duration
+}
+
+// Next implements Scheduler.
+func (s IntervalScheduler) Next(now time.Time) time.Time {
+	return now.Add(s.Every)
+}
+
+// Worker performs the work for one claimed task. payload is the task's
+// payload_json column, passed through unparsed so each Worker can define
+// its own payload shape.
+type Worker interface {
+	Run(ctx context.Context, payload json.RawMessage) error
+}
+
+// Registry maps task type names to the Worker and Scheduler that handle
+// them.
+type Registry struct {
+	workers    map[string]Worker
+	schedulers map[string]Scheduler
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		workers:    make(map[string]Worker),
+		schedulers: make(map[string]Scheduler),
+	}
+}
+
+// Register associates taskType with the Worker that runs it and the
+// Scheduler that decides when it next runs after a successful run.
+func (r *Registry) Register(taskType string, w Worker, s Scheduler) {
+	r.workers[taskType] = w
+	r.schedulers[taskType] = s
+}
+
+// Worker returns the Worker registered for taskType, if any.
+func (r *Registry) Worker(taskType string) (Worker, bool) {
+	w, ok := r.workers[taskType]
+	return w, ok
+}
+
+// Scheduler returns the Scheduler registered for taskType, if any.
+func (r *Registry) Scheduler(taskType string) (Scheduler, bool) {
+	s, ok := r.schedulers[taskType]
+	return s, ok
+}
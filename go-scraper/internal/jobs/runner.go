@@ -0,0 +1,86 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Runner repeatedly claims due tasks from a Store and dispatches them to the
+// Worker registered for their type.
+type Runner struct {
+	Store    *Store
+	Registry *Registry
+	Owner    string
+	LeaseFor time.Duration
+}
+
+// NewRunner builds a Runner that claims tasks as owner, holding each lease
+// for leaseFor before it's considered abandoned and reclaimable.
+func NewRunner(store *Store, registry *Registry, owner string, leaseFor time.Duration) *Runner {
+	return &Runner{Store: store, Registry: registry, Owner: owner, LeaseFor: leaseFor}
+}
+
+// PollOnce claims up to limit due tasks and runs each to completion,
+// recording its outcome and rescheduling it: via its registered Scheduler
+// after a success, or after backoffFor's delay after a failure.
+// It returns the number of tasks it claimed.
+func (r *Runner) PollOnce(ctx context.Context, limit int) (int, error) {
+	tasks, err := r.Store.ClaimDueTasks(ctx, r.Owner, r.LeaseFor, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, task := range tasks {
+		r.runOne(ctx, task)
+	}
+	return len(tasks), nil
+}
+
+func (r *Runner) runOne(ctx context.Context, task Task) {
+	now := time.Now()
+	worker, ok := r.Registry.Worker(task.Type)
+	if !ok {
+		msg := fmt.Sprintf("error: no worker registered for type %q", task.Type)
+		_ = r.Store.RecordResult(ctx, task.ID, msg, now.Add(backoffFor(task.ConsecutiveFailures+1)), true)
+		return
+	}
+	scheduler, ok := r.Registry.Scheduler(task.Type)
+	if !ok {
+		scheduler = IntervalScheduler{Every: r.LeaseFor}
+	}
+
+	status := "ok"
+	nextRunAt := scheduler.Next(now)
+	failed := false
+	if err := worker.Run(ctx, task.PayloadJSON); err != nil {
+		status = fmt.Sprintf("error: %v", err)
+		failed = true
+		nextRunAt = now.Add(backoffFor(task.ConsecutiveFailures + 1))
+	}
+
+	_ = r.Store.RecordResult(ctx, task.ID, status, nextRunAt, failed)
+}
+
+// backoffFor returns how long to wait before retrying a task that has now
+// failed consecutiveFailures times in a row: 1 minute, doubling each
+// additional failure, capped at 1 hour. Without this a task that errors
+// every run (e.g. a scraper hitting a dead endpoint) would retry on exactly
+// the same cadence as a healthy one, forever.
+func backoffFor(consecutiveFailures int) time.Duration {
+	const (
+		base       = time.Minute
+		maxBackoff = time.Hour
+	)
+	if consecutiveFailures <= 0 {
+		return 0
+	}
+	backoff := base
+	for i := 1; i < consecutiveFailures; i++ {
+		backoff *= 2
+		if backoff >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	return backoff
+}
@@ -0,0 +1,111 @@
+package jobs
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Task is a row of scheduled_tasks.
+type Task struct {
+	ID                  int
+	Type                string
+	NextRunAt           time.Time
+	LastStatus          string
+	PayloadJSON         json.RawMessage
+	LeaseOwner          string
+	LeaseExpiresAt      time.Time
+	ConsecutiveFailures int
+}
+
+// Store persists scheduled_tasks and leases them out to worker instances.
+type Store struct {
+	Conn *sql.DB
+}
+
+// NewStore wraps conn in a Store.
+func NewStore(conn *sql.DB) *Store {
+	return &Store{Conn: conn}
+}
+
+// CreateSchema creates the scheduled_tasks table if it doesn't already
+// exist.
+func (s *Store) CreateSchema() error {
+	q := `
+    CREATE TABLE IF NOT EXISTS scheduled_tasks (
+        id SERIAL PRIMARY KEY,
+        type TEXT NOT NULL,
+        next_run_at TIMESTAMP NOT NULL DEFAULT now(),
+        last_status TEXT NOT NULL DEFAULT 'pending',
+        payload_json JSONB NOT NULL DEFAULT '{}',
+        lease_owner TEXT NOT NULL DEFAULT '',
+        lease_expires_at TIMESTAMP NOT NULL DEFAULT '-infinity',
+        consecutive_failures INT NOT NULL DEFAULT 0
+    );
+    `
+	_, err := s.Conn.Exec(q)
+	return err
+}
+
+// Schedule inserts a new recurring task of the given type, due at
+// nextRunAt, carrying payload (marshaled to JSON).
+func (s *Store) Schedule(ctx context.Context, taskType string, nextRunAt time.Time, payload interface{}) error {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("marshal payload: %w", err)
+	}
+	_, err = s.Conn.ExecContext(ctx,
+		`INSERT INTO scheduled_tasks(type, next_run_at, payload_json) VALUES ($1, $2, $3)`,
+		taskType, nextRunAt, raw)
+	return err
+}
+
+// ClaimDueTasks atomically leases up to limit tasks that are due
+// (next_run_at <= now()) and not already leased by a live owner
+// (lease_expires_at < now()), so multiple jobserver instances can poll the
+// same table without double-running a task.
+func (s *Store) ClaimDueTasks(ctx context.Context, owner string, leaseFor time.Duration, limit int) ([]Task, error) {
+	q := `
+    UPDATE scheduled_tasks
+    SET lease_owner = $1, lease_expires_at = now() + $2 * interval '1 second'
+    WHERE id IN (
+        SELECT id FROM scheduled_tasks
+        WHERE next_run_at <= now() AND lease_expires_at < now()
+        ORDER BY next_run_at ASC
+        LIMIT $3
+        FOR UPDATE SKIP LOCKED
+    )
+    RETURNING id, type, next_run_at, last_status, payload_json, lease_owner, lease_expires_at, consecutive_failures;
+    `
+	rows, err := s.Conn.QueryContext(ctx, q, owner, leaseFor.Seconds(), limit)
+	if err != nil {
+		return nil, fmt.Errorf("claim due tasks: %w", err)
+	}
+	defer rows.Close()
+
+	var tasks []Task
+	for rows.Next() {
+		var t Task
+		if err := rows.Scan(&t.ID, &t.Type, &t.NextRunAt, &t.LastStatus, &t.PayloadJSON, &t.LeaseOwner, &t.LeaseExpiresAt, &t.ConsecutiveFailures); err != nil {
+			return nil, fmt.Errorf("scan claimed task: %w", err)
+		}
+		tasks = append(tasks, t)
+	}
+	return tasks, rows.Err()
+}
+
+// RecordResult stores the outcome of running task and reschedules it for
+// nextRunAt, releasing its lease. failed tracks consecutive_failures, which
+// the runner uses to back off a task that keeps erroring instead of retrying
+// it on the same cadence as a healthy one forever.
+func (s *Store) RecordResult(ctx context.Context, taskID int, status string, nextRunAt time.Time, failed bool) error {
+	_, err := s.Conn.ExecContext(ctx,
+		`UPDATE scheduled_tasks
+         SET last_status = $1, next_run_at = $2, lease_expires_at = '-infinity',
+             consecutive_failures = CASE WHEN $4 THEN consecutive_failures + 1 ELSE 0 END
+         WHERE id = $3`,
+		status, nextRunAt, taskID, failed)
+	return err
+}